@@ -0,0 +1,66 @@
+// Package gin provides a gin.HandlerFunc that wires request-scoped logging
+// and panic recovery into the module's log package.
+package gin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/tenyee/simple-iam/pkg/log"
+)
+
+// HeaderRequestID is the HTTP header used to propagate the request ID.
+const HeaderRequestID = "X-Request-ID"
+
+// Middleware returns a gin.HandlerFunc that reads or generates a request ID,
+// stores it on the request context under log.KeyRequestID, logs the
+// completed request as structured fields, and recovers panics into an
+// Error log entry with a stack trace.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(HeaderRequestID)
+		if requestID == "" {
+			requestID = newRequestID()
+		}
+		c.Writer.Header().Set(HeaderRequestID, requestID)
+
+		ctx := context.WithValue(c.Request.Context(), log.KeyRequestID, requestID)
+		c.Request = c.Request.WithContext(ctx)
+
+		defer func() {
+			if err := recover(); err != nil {
+				log.L(c.Request.Context()).Error("panic recovered",
+					zap.Any("error", err),
+					zap.ByteString("stack", debug.Stack()),
+				)
+				c.AbortWithStatus(http.StatusInternalServerError)
+			}
+		}()
+
+		c.Next()
+
+		log.L(c.Request.Context()).Info("http request",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", c.Writer.Status()),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("clientIP", c.ClientIP()),
+		)
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
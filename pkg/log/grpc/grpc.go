@@ -0,0 +1,51 @@
+// Package grpc adapts the module's logger to grpclog.LoggerV2 so that
+// gRPC's internal logging feeds into the same structured log stream.
+package grpc
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc/grpclog"
+
+	"github.com/tenyee/simple-iam/pkg/log"
+)
+
+var _ grpclog.LoggerV2 = &Logger{}
+
+// Logger implements grpclog.LoggerV2 on top of the module's zap logger.
+type Logger struct {
+	l *zap.SugaredLogger
+}
+
+// NewLogger builds a Logger wrapping log.ZapLogger(), skipping two extra
+// caller frames so logged call sites point at the gRPC code that logged,
+// not this adapter.
+func NewLogger() *Logger {
+	return &Logger{l: log.ZapLogger().WithOptions(zap.AddCallerSkip(2)).Sugar()}
+}
+
+// SetGRPCLogger installs a Logger as grpc's package-wide LoggerV2.
+func SetGRPCLogger() {
+	grpclog.SetLoggerV2(NewLogger())
+}
+
+func (l *Logger) Info(args ...interface{})                 { l.l.Info(args...) }
+func (l *Logger) Infoln(args ...interface{})               { l.l.Info(args...) }
+func (l *Logger) Infof(format string, args ...interface{}) { l.l.Infof(format, args...) }
+
+func (l *Logger) Warning(args ...interface{})                 { l.l.Warn(args...) }
+func (l *Logger) Warningln(args ...interface{})               { l.l.Warn(args...) }
+func (l *Logger) Warningf(format string, args ...interface{}) { l.l.Warnf(format, args...) }
+
+func (l *Logger) Error(args ...interface{})                 { l.l.Error(args...) }
+func (l *Logger) Errorln(args ...interface{})               { l.l.Error(args...) }
+func (l *Logger) Errorf(format string, args ...interface{}) { l.l.Errorf(format, args...) }
+
+func (l *Logger) Fatal(args ...interface{})                 { l.l.Fatal(args...) }
+func (l *Logger) Fatalln(args ...interface{})               { l.l.Fatal(args...) }
+func (l *Logger) Fatalf(format string, args ...interface{}) { l.l.Fatalf(format, args...) }
+
+// V reports whether verbosity level level is enabled on the underlying
+// logger, mirroring log.V(level).Enabled().
+func (l *Logger) V(level int) bool {
+	return log.V(level).Enabled()
+}
@@ -0,0 +1,55 @@
+// Package klog routes k8s.io/klog/v2 output into this module's logger so
+// that client-go and other Kubernetes-derived dependencies feed into the
+// same structured log stream.
+package klog
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/klog/v2"
+
+	"github.com/tenyee/simple-iam/pkg/log"
+)
+
+var _ logr.LogSink = &logSink{}
+
+// logSink adapts log.Logger to the logr.LogSink interface klog.SetLogger
+// expects. logr.Logger itself tracks V() verbosity and passes the
+// accumulated level into Enabled/Info, so logSink only needs to carry the
+// underlying log.Logger.
+type logSink struct {
+	l log.Logger
+}
+
+// InitLogger installs l as klog's backing logger via klog.SetLogger.
+func InitLogger(l log.Logger) {
+	klog.SetLogger(logr.New(&logSink{l: l}))
+}
+
+func (s *logSink) Init(_ logr.RuntimeInfo) {}
+
+// Enabled and Info receive a klog *verbosity* (0 = always shown, higher
+// numbers = more verbose debug spew), not a log severity. Passing it
+// straight through to log.Logger.V, which treats its argument as a
+// zapcore.Level, would log verbose client-go chatter at Panic/Fatal
+// severity. Negate it instead: V(0) still lands on Info, V(1) on Debug, and
+// higher verbosities fall further below Debug, only surfacing once the logger's
+// level is lowered to match — the same convention go-logr/zapr uses.
+func (s *logSink) Enabled(level int) bool {
+	return s.l.V(-level).Enabled()
+}
+
+func (s *logSink) Info(level int, msg string, keysAndValues ...interface{}) {
+	s.l.V(-level).Infow(msg, keysAndValues...)
+}
+
+func (s *logSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.l.Errorw(msg, append(keysAndValues, "error", err)...)
+}
+
+func (s *logSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	return &logSink{l: s.l.WithValues(keysAndValues...)}
+}
+
+func (s *logSink) WithName(name string) logr.LogSink {
+	return &logSink{l: s.l.WithName(name)}
+}
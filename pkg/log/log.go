@@ -3,6 +3,7 @@ package log
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sync"
 
 	"log"
@@ -102,8 +103,23 @@ func (l *infoLogger) Infow(msg string, keysAndValues ...interface{}) {
 type zapLogger struct {
 	zapLogger *zap.Logger
 	infoLogger
+
+	// contextKeys are additional context.Context keys (beyond the
+	// hardcoded defaultContextKeys) that L extracts into log fields.
+	contextKeys []string
+
+	// atomicLevel is the level zapLogger was built with, kept so SetLevel
+	// and GetLevel can adjust verbosity at runtime.
+	atomicLevel zap.AtomicLevel
 }
 
+// defaultContextKeys are always extracted from the context by L.
+var defaultContextKeys = []string{KeyRequestID, KeyUsername, KeyWatcherName}
+
+// loggerContextKey is the context.Context key under which NewContext
+// stores a Logger.
+type loggerContextKey struct{}
+
 type noopInfoLogger struct{}
 
 func (l *noopInfoLogger) Enabled() bool                     { return false }
@@ -153,13 +169,52 @@ func handleFields(l *zap.Logger, args []interface{}, additional ...zapcore.Field
 }
 
 func NewLogger(l *zap.Logger) Logger {
+	atomicLevel := zap.NewAtomicLevel()
+	l = l.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &atomicLevelCore{core: core, level: atomicLevel}
+	}))
+
 	return &zapLogger{
 		zapLogger: l,
 		infoLogger: infoLogger{
 			log:   l,
 			level: zap.InfoLevel,
 		},
+		atomicLevel: atomicLevel,
+	}
+}
+
+// atomicLevelCore wraps a zapcore.Core so its effective level is governed by
+// level instead of whatever static enabler the core was built with, which is
+// what lets SetLevel/GetLevel/ServeHTTP adjust the verbosity at runtime for
+// loggers built via NewLogger, not just std.
+type atomicLevelCore struct {
+	core  zapcore.Core
+	level zap.AtomicLevel
+}
+
+func (c *atomicLevelCore) Enabled(lvl zapcore.Level) bool {
+	return c.level.Enabled(lvl)
+}
+
+func (c *atomicLevelCore) With(fields []zapcore.Field) zapcore.Core {
+	return &atomicLevelCore{core: c.core.With(fields), level: c.level}
+}
+
+func (c *atomicLevelCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
 	}
+
+	return ce
+}
+
+func (c *atomicLevelCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.core.Write(ent, fields)
+}
+
+func (c *atomicLevelCore) Sync() error {
+	return c.core.Sync()
 }
 
 func New(opts *Options) *zapLogger {
@@ -191,8 +246,16 @@ func New(opts *Options) *zapLogger {
 		EncodeCaller:   zapcore.ShortCallerEncoder,
 	}
 
+	outputPaths := opts.OutputPaths
+	if opts.rotateEnabled() {
+		registerRotateSink()
+		outputPaths = rotateOutputPaths(outputPaths, opts)
+	}
+
+	atomicLevel := zap.NewAtomicLevelAt(zapLevel)
+
 	loggerConfig := &zap.Config{
-		Level:             zap.NewAtomicLevelAt(zapLevel),
+		Level:             atomicLevel,
 		Development:       opts.Development,
 		DisableCaller:     opts.DisableCaller,
 		DisableStacktrace: opts.DisableStacktrace,
@@ -202,7 +265,7 @@ func New(opts *Options) *zapLogger {
 		},
 		Encoding:         opts.Format,
 		EncoderConfig:    encoderConfig,
-		OutputPaths:      opts.OutputPaths,
+		OutputPaths:      outputPaths,
 		ErrorOutputPaths: opts.ErrorOutputPaths,
 	}
 
@@ -217,6 +280,8 @@ func New(opts *Options) *zapLogger {
 			level: zap.InfoLevel,
 			log:   l,
 		},
+		contextKeys: opts.ContextKeys,
+		atomicLevel: atomicLevel,
 	}
 
 	zap.RedirectStdLog(l)
@@ -278,7 +343,7 @@ func WithValues(keysAndValues ...interface{}) Logger {
 
 func (l *zapLogger) WithValues(keysAndValues ...interface{}) Logger {
 	newLogger := l.zapLogger.With(handleFields(l.zapLogger, keysAndValues)...)
-	return NewLogger(newLogger)
+	return l.derive(newLogger)
 }
 
 func WithName(name string) Logger {
@@ -287,7 +352,17 @@ func WithName(name string) Logger {
 
 func (l *zapLogger) WithName(name string) Logger {
 	newLogger := l.zapLogger.Named(name)
-	return NewLogger(newLogger)
+	return l.derive(newLogger)
+}
+
+// derive returns a copy of l backed by zl, preserving contextKeys and any
+// other logger-level settings that NewLogger would otherwise reset.
+func (l *zapLogger) derive(zl *zap.Logger) *zapLogger {
+	ng := l.clone()
+	ng.zapLogger = zl
+	ng.infoLogger.log = zl
+
+	return ng
 }
 
 func (l *zapLogger) Flush() {
@@ -452,24 +527,87 @@ func L(ctx context.Context) *zapLogger {
 func (l *zapLogger) L(ctx context.Context) *zapLogger {
 	ng := l.clone()
 
-	if requestID := ctx.Value(KeyRequestID); requestID != nil {
-		ng.zapLogger = ng.zapLogger.With(zap.Any(KeyRequestID, requestID))
+	if stored, ok := ctx.Value(loggerContextKey{}).(*zapLogger); ok && stored != nil {
+		ng = stored.clone()
 	}
 
-	if userName := ctx.Value(KeyUsername); userName != nil {
-		ng.zapLogger = ng.zapLogger.With(zap.Any(KeyUsername, userName))
+	for _, key := range append(append([]string{}, defaultContextKeys...), ng.contextKeys...) {
+		if value := ctx.Value(key); value != nil {
+			ng.zapLogger = ng.zapLogger.With(zap.Any(key, value))
+		}
 	}
 
-	if wathcherName := ctx.Value(KeyWatcherName); wathcherName != nil {
-		ng.zapLogger = ng.zapLogger.With(zap.Any(KeyWatcherName, wathcherName))
-	}
+	ng.infoLogger.log = ng.zapLogger
 
 	return ng
 }
 
+// NewContext returns a copy of ctx in which l is stored, so that a later
+// FromContext (or L) call can recover it, together with any WithValues
+// bindings accumulated on it, across layers. Only loggers created by this
+// package are stored; for any other Logger implementation ctx is returned
+// unchanged.
+func NewContext(ctx context.Context, l Logger) context.Context {
+	zl, ok := l.(*zapLogger)
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, loggerContextKey{}, zl)
+}
+
+// FromContext returns the Logger previously stored in ctx via NewContext,
+// or the package-level std logger if ctx carries none.
+func FromContext(ctx context.Context) Logger {
+	if zl, ok := ctx.Value(loggerContextKey{}).(*zapLogger); ok && zl != nil {
+		return zl
+	}
+
+	return std
+}
+
 //nolint:predeclared
 func (l *zapLogger) clone() *zapLogger {
 	copy := *l
 
 	return &copy
 }
+
+var _ http.Handler = &zapLogger{}
+
+// SetLevel changes std's logging level at runtime. level is parsed the
+// same way as Options.Level (e.g. "debug", "info", "warn").
+func SetLevel(level string) error {
+	return std.SetLevel(level)
+}
+
+func (l *zapLogger) SetLevel(level string) error {
+	var zapLevel zapcore.Level
+	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+		return err
+	}
+
+	l.atomicLevel.SetLevel(zapLevel)
+
+	return nil
+}
+
+// GetLevel returns std's current logging level.
+func GetLevel() string {
+	return std.GetLevel()
+}
+
+func (l *zapLogger) GetLevel() string {
+	return l.atomicLevel.Level().String()
+}
+
+// ServeHTTP implements zap's standard GET/PUT JSON contract
+// (`{"level":"debug"}`) for reading and changing std's level at runtime.
+// Callers mount it at an endpoint of their choosing, e.g. "/debug/log/level".
+func ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	std.ServeHTTP(w, r)
+}
+
+func (l *zapLogger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l.atomicLevel.ServeHTTP(w, r)
+}
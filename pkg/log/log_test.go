@@ -1,6 +1,10 @@
 package log
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"go.uber.org/zap"
@@ -19,3 +23,53 @@ func TestLog(t *testing.T) {
 	logger.Infof("hahaha%s-%s", "k1", "1")
 	logger.Infow("hahaha", "k1", "1")
 }
+
+func TestContext(t *testing.T) {
+	logger := WithValues("trace_id", "trace-1")
+
+	ctx := NewContext(context.Background(), logger)
+	if FromContext(ctx) != logger {
+		t.Fatalf("FromContext did not return the logger stored by NewContext")
+	}
+
+	if FromContext(context.Background()) == nil {
+		t.Fatalf("FromContext should fall back to std for a bare context")
+	}
+}
+
+func TestSetLevel(t *testing.T) {
+	defer SetLevel("info")
+
+	if err := SetLevel("debug"); err != nil {
+		t.Fatalf("SetLevel returned an error: %v", err)
+	}
+
+	if GetLevel() != "debug" {
+		t.Fatalf("GetLevel() = %q, want %q", GetLevel(), "debug")
+	}
+
+	req := httptest.NewRequest(http.MethodPut, "/debug/log/level", strings.NewReader(`{"level":"warn"}`))
+	w := httptest.NewRecorder()
+	ServeHTTP(w, req)
+
+	if GetLevel() != "warn" {
+		t.Fatalf("GetLevel() after ServeHTTP PUT = %q, want %q", GetLevel(), "warn")
+	}
+}
+
+func TestNewLoggerSetLevel(t *testing.T) {
+	zl, err := zap.NewProduction()
+	if err != nil {
+		t.Fatalf("zap.NewProduction() returned an error: %v", err)
+	}
+
+	logger := NewLogger(zl).(*zapLogger)
+
+	if err := logger.SetLevel("debug"); err != nil {
+		t.Fatalf("SetLevel returned an error: %v", err)
+	}
+
+	if logger.GetLevel() != "debug" {
+		t.Fatalf("GetLevel() = %q, want %q", logger.GetLevel(), "debug")
+	}
+}
@@ -0,0 +1,49 @@
+// Package logrus routes github.com/sirupsen/logrus output into this
+// module's logger via a logrus.Hook, so that logrus-based dependencies
+// feed into the same structured log stream.
+package logrus
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/tenyee/simple-iam/pkg/log"
+)
+
+var _ logrus.Hook = &Hook{}
+
+// Hook forwards every logrus entry's message and fields to the matching
+// level of this module's package-level logger. Panic and Fatal entries are
+// forwarded at Error level, so the hook itself never panics or exits.
+type Hook struct{}
+
+// NewHook returns a Hook ready to be registered with logrus.AddHook.
+func NewHook() *Hook {
+	return &Hook{}
+}
+
+// Levels reports that the hook fires on all levels.
+func (h *Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire forwards entry to the log level matching entry.Level, with Panic and
+// Fatal mapped to Error.
+func (h *Hook) Fire(entry *logrus.Entry) error {
+	args := make([]interface{}, 0, len(entry.Data)*2)
+	for k, v := range entry.Data {
+		args = append(args, k, v)
+	}
+
+	switch entry.Level {
+	case logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel:
+		log.Errorw(entry.Message, args...)
+	case logrus.WarnLevel:
+		log.Warnw(entry.Message, args...)
+	case logrus.DebugLevel, logrus.TraceLevel:
+		log.Debugw(entry.Message, args...)
+	default:
+		log.Infow(entry.Message, args...)
+	}
+
+	return nil
+}
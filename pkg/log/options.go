@@ -26,6 +26,23 @@ type Options struct {
 	EnableColor       bool     `json:"enable-color"`
 	Development       bool     `json:"development"`
 	Name              string   `json:"name"`
+
+	// RotateMaxSizeMB is the maximum size in megabytes of a log file before
+	// it gets rotated. Setting any of the Rotate* fields enables rotation
+	// for every OutputPaths entry that is a plain file path; "stdout",
+	// "stderr" and entries that already carry a URL scheme are left as is.
+	RotateMaxSizeMB int `json:"rotate-max-size-mb"`
+	// RotateMaxAgeDays is the maximum number of days to retain old log files.
+	RotateMaxAgeDays int `json:"rotate-max-age-days"`
+	// RotateMaxBackups is the maximum number of old log files to retain.
+	RotateMaxBackups int `json:"rotate-max-backups"`
+	// RotateCompress enables gzip compression of rotated log files.
+	RotateCompress bool `json:"rotate-compress"`
+
+	// ContextKeys are additional context.Context keys, beyond the built-in
+	// requestID/username/watcher, that L(ctx) extracts into log fields
+	// (e.g. trace_id, span_id, tenant_id).
+	ContextKeys []string `json:"context-keys"`
 }
 
 // NewOptions create a Options object with default parameters.
@@ -77,6 +94,12 @@ func (o *Options) Build() error {
 		encodeLevel = zapcore.CapitalColorLevelEncoder
 	}
 
+	outputPaths := o.OutputPaths
+	if o.rotateEnabled() {
+		registerRotateSink()
+		outputPaths = rotateOutputPaths(outputPaths, o)
+	}
+
 	zapConfig := &zap.Config{
 		Level:             zap.NewAtomicLevelAt(zapcore.Level(zapLevel)),
 		Development:       o.Development,
@@ -101,7 +124,7 @@ func (o *Options) Build() error {
 			EncodeCaller:   zapcore.ShortCallerEncoder,
 			EncodeName:     zapcore.FullNameEncoder,
 		},
-		OutputPaths:      o.OutputPaths,
+		OutputPaths:      outputPaths,
 		ErrorOutputPaths: o.ErrorOutputPaths,
 	}
 
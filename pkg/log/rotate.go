@@ -0,0 +1,114 @@
+package log
+
+import (
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+// rotateScheme is the zap.Sink scheme used for rotated file output, e.g.
+// "rotate:///var/log/app.log".
+const rotateScheme = "rotate"
+
+var registerRotateSinkOnce sync.Once
+
+// rotateEnabled reports whether any rotation option has been configured.
+func (o *Options) rotateEnabled() bool {
+	return o.RotateMaxSizeMB > 0 || o.RotateMaxAgeDays > 0 || o.RotateMaxBackups > 0 || o.RotateCompress
+}
+
+// registerRotateSink registers the "rotate" zap.Sink scheme once per process.
+func registerRotateSink() {
+	registerRotateSinkOnce.Do(func() {
+		if err := zap.RegisterSink(rotateScheme, newRotateSink); err != nil {
+			panic(err)
+		}
+	})
+}
+
+// rotateOutputPaths rewrites plain file paths in paths into rotate:// sink
+// URLs carrying the rotation policy, leaving "stdout", "stderr" and paths
+// that already declare a scheme (including an explicit rotate:// one)
+// untouched.
+func rotateOutputPaths(paths []string, o *Options) []string {
+	out := make([]string, len(paths))
+
+	for i, path := range paths {
+		out[i] = rotateOutputPath(path, o)
+	}
+
+	return out
+}
+
+func rotateOutputPath(path string, o *Options) string {
+	if path == "stdout" || path == "stderr" {
+		return path
+	}
+
+	if u, err := url.Parse(path); err == nil && u.Scheme != "" {
+		return path
+	}
+
+	// Resolve to an absolute path before building the URL: a relative path
+	// used verbatim would be parsed back out of u.Host, not u.Path, leaving
+	// newRotateSink with an empty Filename.
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = path
+	}
+
+	values := url.Values{}
+	values.Set("maxsize", strconv.Itoa(o.RotateMaxSizeMB))
+	values.Set("maxage", strconv.Itoa(o.RotateMaxAgeDays))
+	values.Set("maxbackups", strconv.Itoa(o.RotateMaxBackups))
+	values.Set("compress", strconv.FormatBool(o.RotateCompress))
+
+	u := url.URL{
+		Scheme:   rotateScheme,
+		Path:     absPath,
+		RawQuery: values.Encode(),
+	}
+
+	return u.String()
+}
+
+// rotateSink adapts a *lumberjack.Logger, which already implements
+// io.WriteCloser, to the zap.Sink interface by adding a no-op Sync.
+type rotateSink struct {
+	*lumberjack.Logger
+}
+
+func (s *rotateSink) Sync() error { return nil }
+
+// newRotateSink builds a zap.Sink backed by lumberjack from a rotate://
+// URL produced by rotateOutputPath.
+func newRotateSink(u *url.URL) (zap.Sink, error) {
+	query := u.Query()
+
+	maxSize, err := strconv.Atoi(query.Get("maxsize"))
+	if err != nil {
+		maxSize = 0
+	}
+
+	maxAge, err := strconv.Atoi(query.Get("maxage"))
+	if err != nil {
+		maxAge = 0
+	}
+
+	maxBackups, err := strconv.Atoi(query.Get("maxbackups"))
+	if err != nil {
+		maxBackups = 0
+	}
+
+	return &rotateSink{Logger: &lumberjack.Logger{
+		Filename:   u.Path,
+		MaxSize:    maxSize,
+		MaxAge:     maxAge,
+		MaxBackups: maxBackups,
+		Compress:   query.Get("compress") == "true",
+	}}, nil
+}
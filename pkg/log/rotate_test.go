@@ -0,0 +1,48 @@
+package log
+
+import (
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Options_RotateEnabled(t *testing.T) {
+	opts := NewOptions()
+	assert.False(t, opts.rotateEnabled())
+
+	opts.RotateMaxSizeMB = 100
+	assert.True(t, opts.rotateEnabled())
+}
+
+func Test_RotateOutputPath(t *testing.T) {
+	opts := NewOptions()
+	opts.RotateMaxSizeMB = 100
+	opts.RotateMaxAgeDays = 7
+	opts.RotateMaxBackups = 3
+	opts.RotateCompress = true
+
+	assert.Equal(t, "stdout", rotateOutputPath("stdout", opts))
+	assert.Equal(t, "stderr", rotateOutputPath("stderr", opts))
+
+	got := rotateOutputPath("/var/log/app.log", opts)
+	assert.Equal(t, "rotate:///var/log/app.log?compress=true&maxage=7&maxbackups=3&maxsize=100", got)
+
+	assert.Equal(t, "rotate:///var/log/app.log?maxsize=1", rotateOutputPath("rotate:///var/log/app.log?maxsize=1", opts))
+}
+
+func Test_RotateOutputPath_RelativePath(t *testing.T) {
+	opts := NewOptions()
+	opts.RotateMaxSizeMB = 100
+
+	absPath, err := filepath.Abs("app.log")
+	assert.NoError(t, err)
+
+	got := rotateOutputPath("app.log", opts)
+
+	u, err := url.Parse(got)
+	assert.NoError(t, err)
+	assert.Equal(t, rotateScheme, u.Scheme)
+	assert.Equal(t, absPath, u.Path)
+}